@@ -18,6 +18,7 @@ from kubernetes.dynamic.exceptions import ForbiddenError, NotFoundError
 
 if TYPE_CHECKING:
     from kubernetes.dynamic import DynamicClient
+from ocp_resources.custom_resource_definition import CustomResourceDefinition
 from ocp_resources.forklift_controller import ForkliftController
 from ocp_resources.namespace import Namespace
 from ocp_resources.network_attachment_definition import NetworkAttachmentDefinition
@@ -35,6 +36,7 @@ from pytest_testconfig import config as py_config
 from timeout_sampler import TimeoutSampler
 
 from exceptions.exceptions import (
+    ForkliftCrdVersionMismatchError,
     ForkliftPodsNotRunningError,
     MissingProvidersFileError,
     MtvOperatorNotInstalledError,
@@ -50,21 +52,24 @@ from libs.forklift_inventory import (
     VsphereForkliftInventory,
 )
 from libs.providers.openshift import OCPProvider
-from utilities.constants import MTV_OPERATOR_NAME
+from utilities.constants import FORKLIFT_API_GROUP, FORKLIFT_API_VERSION, FORKLIFT_CRD_NAMES, MTV_OPERATOR_NAME
 from utilities.hooks import create_hook_if_configured
 from utilities.logger import separator, setup_logging
 from utilities.mtv_migration import get_vm_suffix
 from utilities.must_gather import run_must_gather
 from utilities.naming import generate_name_with_uuid
 from utilities.pytest_utils import (
+    ProgressFileReporter,
     collect_created_resources,
     enrich_junit_xml,
+    get_unknown_tc_options,
     is_dry_run,
     prepare_base_path,
     session_teardown,
     setup_ai_analysis,
+    stamp_junit_git_properties,
 )
-from utilities.resources import create_and_store_resource, get_or_create_namespace
+from utilities.resources import create_and_store_resource, get_namespace_ownership_labels, get_or_create_namespace
 from utilities.ssh_utils import SSHConnectionManager
 from utilities.utils import (
     create_source_cnv_vms,
@@ -98,6 +103,13 @@ def pytest_addoption(parser):
     analyze_with_ai_group = parser.getgroup(name="Analyze with AI")
     analyze_with_ai_group.addoption("--analyze-with-ai", action="store_true", help="Analyze test failures using AI")
 
+    progress_group = parser.getgroup(name="Progress")
+    progress_group.addoption(
+        "--progress-file",
+        help="Path to a JSON file updated with the current test and result counts while the run is in progress",
+        default=None,
+    )
+
     providers_group = parser.getgroup(name="Providers")
     providers_group.addoption(
         "--providers-json",
@@ -140,6 +152,15 @@ def pytest_sessionstart(session):
     if not is_dry_run(session.config):
         BASIC_LOGGER.info(f"{separator(symbol_='-', val='SESSION START')}")
 
+        # Checked first, a mistyped required key would otherwise be reported as missing
+        if unknown_tc_options := get_unknown_tc_options(config=session.config):
+            pytest.exit(
+                reason=f"Unknown --tc options (key: close matches): {unknown_tc_options}. "
+                "Supported keys are the top-level names in the --tc-file and CLI_ONLY_TC_OPTIONS "
+                "(utilities/pytest_utils.py)",
+                returncode=1,
+            )
+
         missing_configs: list[str] = []
 
         for _req in required_config:
@@ -176,6 +197,17 @@ def pytest_sessionstart(session):
     if session.config.getoption("analyze_with_ai"):
         setup_ai_analysis(session)
 
+    # xdist workers report to the controller, only the controller publishes progress
+    progress_file = session.config.getoption("progress_file")
+    if progress_file and not is_dry_run(session.config) and not hasattr(session.config, "workerinput"):
+        session.config.pluginmanager.register(
+            ProgressFileReporter(progress_file=Path(progress_file)), name="progress_file_reporter"
+        )
+
+    # Trace results back to the exact test code version
+    if not is_dry_run(session.config) and not hasattr(session.config, "workerinput"):
+        stamp_junit_git_properties(config=session.config)
+
 
 def pytest_fixture_setup(fixturedef, request):
     LOGGER.info(f"Executing {fixturedef.scope} fixture: {fixturedef.argname}")
@@ -408,7 +440,12 @@ def pytest_harvest_xdist_cleanup():
 
 @pytest.fixture(scope="session", autouse=True)
 def autouse_fixtures(
-    source_provider_data, nfs_storage_profile, base_resource_name, forklift_pods_state, virtctl_binary
+    source_provider_data,
+    nfs_storage_profile,
+    base_resource_name,
+    forklift_pods_state,
+    forklift_crd_versions,
+    virtctl_binary,
 ):
     # source_provider_data called here to fail fast in provider not found in the providers list from config
     yield
@@ -438,6 +475,7 @@ def target_namespace(fixture_store, session_uuid, ocp_admin_client):
         "pod-security.kubernetes.io/enforce": "restricted",
         "pod-security.kubernetes.io/enforce-version": "latest",
         "mutatevirtualmachines.kubemacpool.io": "ignore",
+        **get_namespace_ownership_labels(fixture_store=fixture_store),
     }
 
     _target_namespace: str = py_config["target_namespace_prefix"]
@@ -635,7 +673,7 @@ def precopy_interval_forkliftcontroller(ocp_admin_client, mtv_namespace):
 @pytest.fixture(scope="session")
 def destination_provider(session_uuid, ocp_admin_client, target_namespace, fixture_store):
     kind_dict = {
-        "apiVersion": "forklift.konveyor.io/v1beta1",
+        "apiVersion": f"{FORKLIFT_API_GROUP}/{FORKLIFT_API_VERSION}",
         "kind": "Provider",
         "metadata": {"name": f"{session_uuid}-local-ocp-provider", "namespace": target_namespace},
         "spec": {"secret": {}, "type": "openshift", "url": ""},
@@ -1326,6 +1364,45 @@ def forklift_pods_state(ocp_admin_client: DynamicClient) -> None:
             return
 
 
+@pytest.fixture(scope="session")
+def forklift_crd_versions(ocp_admin_client: DynamicClient, forklift_pods_state: None) -> None:
+    """Fail fast when the installed forklift CRDs do not serve the API version the suite uses.
+
+    Avoids confusing schema errors when running against an incompatible MTV version.
+    CRDs that cannot be read due to RBAC are skipped, the others are still checked.
+
+    Args:
+        ocp_admin_client (DynamicClient): OpenShift client used to read the CRDs.
+        forklift_pods_state (None): Ensures MTV is installed and running before the check.
+
+    Raises:
+        ForkliftCrdVersionMismatchError: If a forklift CRD is missing or does not serve FORKLIFT_API_VERSION.
+    """
+    served_versions: dict[str, list[str]] = {}
+
+    for crd_name in FORKLIFT_CRD_NAMES:
+        crd = CustomResourceDefinition(client=ocp_admin_client, name=crd_name)
+        try:
+            if not crd.exists:
+                served_versions[crd_name] = []
+                continue
+
+            crd_served_versions = [
+                version["name"] for version in crd.instance.spec.versions if version.get("served", False)
+            ]
+        except ForbiddenError:
+            LOGGER.warning(f"Insufficient RBAC permissions to read CRD '{crd_name}', skipping its version check")
+            continue
+
+        if FORKLIFT_API_VERSION not in crd_served_versions:
+            served_versions[crd_name] = crd_served_versions
+
+    if served_versions:
+        raise ForkliftCrdVersionMismatchError(expected_version=FORKLIFT_API_VERSION, served_versions=served_versions)
+
+    LOGGER.info(f"Forklift CRD version check for '{FORKLIFT_API_VERSION}' passed")
+
+
 @pytest.fixture(scope="session")
 def source_provider_inventory(
     ocp_admin_client: DynamicClient, mtv_namespace: str, source_provider: BaseProvider
@@ -1358,11 +1435,40 @@ def source_vms_namespace(source_provider, fixture_store, ocp_admin_client, sessi
             fixture_store=fixture_store,
             client=ocp_admin_client,
             name=f"{session_uuid}-source-vms",
-            label={"mutatevirtualmachines.kubemacpool.io": "ignore"},
+            label={
+                "mutatevirtualmachines.kubemacpool.io": "ignore",
+                **get_namespace_ownership_labels(fixture_store=fixture_store),
+            },
         )
         return namespace.name
 
 
+@pytest.fixture(scope="session")
+def live_migration_capable_cluster(ocp_admin_client: DynamicClient) -> None:
+    """Skip live migration tests when the cluster cannot live migrate VMs.
+
+    Live migration needs at least two worker nodes and a storage class whose
+    StorageProfile offers ReadWriteMany access.
+
+    Args:
+        ocp_admin_client (DynamicClient): OpenShift client used to read nodes and the StorageProfile.
+    """
+    worker_nodes = get_worker_nodes(ocp_client=ocp_admin_client)
+    if len(worker_nodes) < 2:
+        pytest.skip(f"Live migration requires at least two worker nodes, found {worker_nodes}")
+
+    storage_class = py_config["storage_class"]
+    storage_profile = StorageProfile(client=ocp_admin_client, name=storage_class)
+    claim_property_sets = storage_profile.instance.status.claimPropertySets if storage_profile.exists else None
+    access_modes = [
+        access_mode for property_set in claim_property_sets or [] for access_mode in property_set.accessModes or []
+    ]
+    if "ReadWriteMany" not in access_modes:
+        pytest.skip(
+            f"Live migration requires ReadWriteMany storage, storage class '{storage_class}' has {access_modes}"
+        )
+
+
 @pytest.fixture(scope="session")
 def multus_cni_config() -> str:
     bridge_type_and_name = "cnv-bridge"
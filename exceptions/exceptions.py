@@ -6,6 +6,24 @@ class ForkliftPodsNotRunningError(Exception):
     pass
 
 
+class ForkliftCrdVersionMismatchError(Exception):
+    """Raised when the cluster's forklift CRDs do not serve the API version the suite uses."""
+
+    def __init__(self, expected_version: str, served_versions: dict[str, list[str]]) -> None:
+        """Initialize ForkliftCrdVersionMismatchError.
+
+        Args:
+            expected_version (str): The forklift API version the suite creates resources with.
+            served_versions (dict[str, list[str]]): Mismatching CRD names mapped to the versions they serve.
+        """
+        self.expected_version = expected_version
+        self.served_versions = served_versions
+        super().__init__(
+            f"Forklift CRDs do not serve '{expected_version}': {served_versions}. "
+            "The installed MTV version is not compatible with this test suite"
+        )
+
+
 class MtvOperatorNotInstalledError(Exception):
     """Raised when the MTV operator Subscription is not found in the expected namespace."""
 
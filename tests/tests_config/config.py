@@ -50,6 +50,14 @@ tests_params: dict = {
         ],
         "warm_migration": False,
     },
+    "test_cold_migration_live_migration": {
+        "virtual_machines": [
+            {"name": "mtv-tests-rhel8", "source_vm_power": "on", "guest_agent": True},
+        ],
+        "warm_migration": False,
+        "target_power_state": "on",
+        "check_live_migration": True,
+    },
     "test_cold_remote_ocp": {
         "virtual_machines": [
             {"name": "mtv-tests-rhel8"},
@@ -2,6 +2,37 @@ import importlib
 import json
 import sys
 
+from ocp_resources.hook import Hook
+from ocp_resources.migration import Migration
+from ocp_resources.namespace import Namespace
+from ocp_resources.network_attachment_definition import NetworkAttachmentDefinition
+from ocp_resources.network_map import NetworkMap
+from ocp_resources.plan import Plan
+from ocp_resources.provider import Provider
+from ocp_resources.resource import get_client
+from ocp_resources.secret import Secret
+from ocp_resources.storage_map import StorageMap
+from ocp_resources.virtual_machine import VirtualMachine
+from ocp_resources.virtual_machine_instance_migration import VirtualMachineInstanceMigration
+
+# Must match RUN_ID_LABEL in utilities/resources.py
+RUN_ID_LABEL = "mtv-api-tests/run-id"
+
+# Deletion order: MTV CRs before the resources they reference, namespaces last
+RUN_ID_RESOURCE_KINDS = (
+    Migration,
+    Plan,
+    Hook,
+    StorageMap,
+    NetworkMap,
+    Provider,
+    Secret,
+    NetworkAttachmentDefinition,
+    VirtualMachineInstanceMigration,
+    VirtualMachine,
+    Namespace,
+)
+
 
 def clean_cluster_by_resources_file(resources_file: str) -> None:
     with open(resources_file, "r") as fd:
@@ -18,9 +49,32 @@ def clean_cluster_by_resources_file(resources_file: str) -> None:
             _resource_class(**_kwargs).clean_up()
 
 
+def clean_cluster_by_run_id(run_id: str) -> None:
+    """Delete all resources labeled with the given test run ID.
+
+    Only resources created by the run passed with --tc=run_id:<run_id> are selected,
+    so parallel runs on the same cluster are left untouched.
+
+    Args:
+        run_id (str): The run ID the resources were labeled with.
+    """
+    client = get_client()
+    label_selector = f"{RUN_ID_LABEL}={run_id}"
+
+    for _resource_class in RUN_ID_RESOURCE_KINDS:
+        for _resource in _resource_class.get(client=client, label_selector=label_selector):
+            print(f"Deleting {_resource.kind} {_resource.name}")
+            _resource.clean_up()
+
+
 if __name__ == "__main__":
-    if len(sys.argv) != 2:
+    if len(sys.argv) == 3 and sys.argv[1] == "--run-id":
+        clean_cluster_by_run_id(run_id=sys.argv[2])
+
+    elif len(sys.argv) == 2:
+        clean_cluster_by_resources_file(resources_file=sys.argv[1])
+
+    else:
         print("Usage: python clean_cluster.py <resources_file>")
+        print("       python clean_cluster.py --run-id <run_id>")
         sys.exit(1)
-
-    clean_cluster_by_resources_file(resources_file=sys.argv[1])
@@ -1 +1,13 @@
 MTV_OPERATOR_NAME: str = "mtv-operator"
+MTV_API_TESTS_LABEL_PREFIX: str = "mtv-api-tests"
+FORKLIFT_API_GROUP: str = "forklift.konveyor.io"
+FORKLIFT_API_VERSION: str = "v1beta1"
+# Forklift CRDs the suite creates resources from
+FORKLIFT_CRD_NAMES: tuple[str, ...] = (
+    f"plans.{FORKLIFT_API_GROUP}",
+    f"migrations.{FORKLIFT_API_GROUP}",
+    f"providers.{FORKLIFT_API_GROUP}",
+    f"storagemaps.{FORKLIFT_API_GROUP}",
+    f"networkmaps.{FORKLIFT_API_GROUP}",
+    f"hooks.{FORKLIFT_API_GROUP}",
+)
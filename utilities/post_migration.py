@@ -14,6 +14,7 @@ from ocp_resources.network_map import NetworkMap
 from ocp_resources.provider import Provider
 from ocp_resources.secret import Secret
 from ocp_resources.storage_map import StorageMap
+from ocp_resources.virtual_machine_instance_migration import VirtualMachineInstanceMigration
 from paramiko.ssh_exception import AuthenticationException, ChannelException, NoValidConnectionsError, SSHException
 from pyhelper_utils.exceptions import CommandExecFailed
 from pytest_testconfig import py_config
@@ -23,6 +24,7 @@ from timeout_sampler import TimeoutExpiredError, TimeoutSampler
 from libs.base_provider import BaseProvider
 from libs.forklift_inventory import ForkliftInventory
 from libs.providers.rhv import OvirtProvider
+from utilities.resources import create_and_store_resource
 from utilities.ssh_utils import SSHConnectionManager
 from utilities.utils import get_cluster_version, get_value_from_py_config, rhv_provider
 
@@ -32,6 +34,8 @@ LOGGER = get_logger(name=__name__)
 KUBERNETES_MAX_NAME_LENGTH: int = 63
 KUBERNETES_MAX_GENERATE_NAME_PREFIX_LENGTH: int = 58
 
+LIVE_MIGRATION_TIMEOUT: int = 600
+
 
 def get_ssh_credentials_from_provider_config(
     source_provider_data: dict[str, Any], source_vm_info: dict[str, Any]
@@ -1105,6 +1109,69 @@ def check_vm_affinity(
     LOGGER.info(f"VM {vm_name} affinity verified successfully: {actual_affinity}")
 
 
+def check_vm_live_migration(destination_vm: dict[str, Any], destination_provider: BaseProvider) -> None:
+    """Live migrate a migrated VM to another node and verify it moved.
+
+    Validates post-migration VM mobility by creating a VirtualMachineInstanceMigration
+    and waiting for it to succeed. Powered-off VMs have no VMI to migrate and are skipped.
+    Plans using target_node_selector usually match a single labeled node, leaving no
+    other node to migrate to, so do not combine them with check_live_migration.
+
+    Args:
+        destination_vm: Destination VM information including provider_vm_api, power_state and node_name
+        destination_provider: Destination provider holding the fixture store for resource tracking
+
+    Raises:
+        ValueError: If the destination provider has no fixture store
+        TimeoutExpiredError: If the live migration does not finish in time
+        pytest.fail: If VM has no node assignment, the live migration failed or VM is still on the original node
+    """
+    vm_name = destination_vm["name"]
+    if destination_vm.get("power_state") != "on":
+        LOGGER.info(f"VM {vm_name} is not running, skipping live migration check")
+        return
+
+    source_node = destination_vm.get("node_name")
+    if not source_node:
+        pytest.fail(f"VM {vm_name} has no node assignment, cannot live migrate")
+
+    if destination_provider.fixture_store is None:
+        raise ValueError("Destination provider has no fixture_store, cannot track live migration resource")
+
+    vm_api = destination_vm["provider_vm_api"]
+    LOGGER.info(f"Live migrating VM {vm_name} from node {source_node}")
+
+    vmim = create_and_store_resource(
+        client=vm_api.client,
+        fixture_store=destination_provider.fixture_store,
+        resource=VirtualMachineInstanceMigration,
+        namespace=vm_api.namespace,
+        vmi_name=vm_name,
+    )
+
+    # The VMIM is not handled by session teardown and may live in a reused namespace, remove it here
+    try:
+        for sample in TimeoutSampler(
+            wait_timeout=LIVE_MIGRATION_TIMEOUT,
+            sleep=5,
+            func=lambda: vmim.instance.status.phase if vmim.instance.status else None,
+        ):
+            if sample == vmim.Status.SUCCEEDED:
+                break
+
+            # Fail immediately instead of waiting for the timeout
+            if sample == vmim.Status.FAILED:
+                pytest.fail(f"VM {vm_name} live migration failed. \nstatus:\n\t{vmim.instance.status}")
+    finally:
+        vmim.clean_up()
+
+    target_node = vm_api.vmi.instance.status.nodeName
+    if target_node == source_node:
+        pytest.fail(f"VM {vm_name} live migration succeeded but VM is still on node {source_node}")
+
+    LOGGER.info(f"VM {vm_name} live migrated successfully from {source_node} to {target_node}")
+
+
 def check_ssl_configuration(source_provider: BaseProvider) -> None:
     """
     Verify that Provider secret's insecureSkipVerify matches the global configuration.
@@ -1356,6 +1423,13 @@ def check_vms(
             except Exception as exp:
                 res[vm_name].append(f"check_vm_affinity - {str(exp)}")
 
+        # Check post-migration VM mobility if configured
+        if plan.get("check_live_migration", False):
+            try:
+                check_vm_live_migration(destination_vm=destination_vm, destination_provider=destination_provider)
+            except Exception as exp:
+                res[vm_name].append(f"check_vm_live_migration - {str(exp)}")
+
         if rhv_provider(source_provider_data) and isinstance(source_provider, OvirtProvider):
             try:
                 check_false_vm_power_off(source_provider=source_provider, source_vm=source_vm)
@@ -1,10 +1,13 @@
 from __future__ import annotations
 
+import ast
 import contextlib
+import difflib
 import json
 import os
 import shutil
 from concurrent.futures import ThreadPoolExecutor, as_completed
+from datetime import UTC, datetime
 from pathlib import Path
 from typing import TYPE_CHECKING, Any
 
@@ -21,6 +24,7 @@ from ocp_resources.provider import Provider
 from ocp_resources.secret import Secret
 from ocp_resources.storage_map import StorageMap
 from ocp_resources.virtual_machine import VirtualMachine
+from pyhelper_utils.shell import run_command
 from simple_logger.logger import get_logger
 
 from exceptions.exceptions import SessionTeardownError
@@ -36,6 +40,17 @@ if TYPE_CHECKING:
 
 LOGGER = get_logger(__name__)
 
+# Keys read from py_config that are not defined in the --tc-file and are only passed with --tc
+CLI_ONLY_TC_OPTIONS: frozenset[str] = frozenset({
+    "cluster_host",
+    "cluster_password",
+    "cluster_username",
+    "run_id",
+    "source_provider",
+    "storage_class",
+    "target_ocp_version",
+})
+
 
 def is_dry_run(config: pytest.Config) -> bool:
     """Check if pytest was invoked in dry-run mode (collectonly or setupplan).
@@ -49,6 +64,203 @@ def is_dry_run(config: pytest.Config) -> bool:
     return config.option.setupplan or config.option.collectonly
 
 
+def get_supported_tc_options(config: pytest.Config) -> set[str]:
+    """Get the keys that may be overridden with --tc=<key>:<value>.
+
+    These are the top-level names defined in the python --tc-file(s) plus CLI_ONLY_TC_OPTIONS,
+    so new keys added to tests/tests_config/config.py are supported without extra changes.
+
+    Args:
+        config (pytest.Config): The pytest config object.
+
+    Returns:
+        set[str]: The supported top-level config keys.
+    """
+    supported_options: set[str] = set(CLI_ONLY_TC_OPTIONS)
+
+    for tc_file in config.getoption("testconfig") or []:
+        tc_file_path = Path(tc_file)
+        if tc_file_path.suffix != ".py":
+            continue
+
+        for node in ast.parse(tc_file_path.read_text()).body:
+            if isinstance(node, ast.AnnAssign) and isinstance(node.target, ast.Name):
+                supported_options.add(node.target.id)
+            elif isinstance(node, ast.Assign):
+                supported_options.update(target.id for target in node.targets if isinstance(target, ast.Name))
+
+    return supported_options
+
+
+def get_unknown_tc_options(config: pytest.Config) -> dict[str, list[str]]:
+    """Find --tc override keys that are not supported (see get_supported_tc_options).
+
+    Nested keys (``tests_params.x``) are validated by their top-level key.
+
+    Args:
+        config (pytest.Config): The pytest config object.
+
+    Returns:
+        dict[str, list[str]]: Unknown keys mapped to the closest supported keys (may be empty).
+    """
+    # pytest-testconfig collects every --tc value (command line, PYTEST_ADDOPTS, ini addopts) as "<key>:<value>"
+    override_keys: list[str] = [
+        override.split(":", 1)[0].split(".", 1)[0] for override in config.getoption("overrides") or []
+    ]
+
+    supported_options = get_supported_tc_options(config=config)
+
+    return {
+        key: difflib.get_close_matches(key, supported_options)
+        for key in override_keys
+        if key not in supported_options
+    }
+
+
+class ProgressFileReporter:
+    """Pytest plugin that publishes live run progress to a JSON file.
+
+    The file holds the run status (running, tearing_down, finished), the current test,
+    the last finished result and per-outcome counts, and is rewritten atomically so
+    external tools can poll it at any time.
+    Register it only in the controller process; with pytest-xdist the controller
+    receives every worker's reports.
+    """
+
+    def __init__(self, progress_file: Path) -> None:
+        """Initialize the reporter and write the initial progress file.
+
+        Args:
+            progress_file (Path): Path of the JSON progress file to maintain.
+        """
+        self.progress_file = progress_file
+        self.progress: dict[str, Any] = {
+            "pid": os.getpid(),
+            "status": "running",
+            "current_test": "",
+            "last_result": {},
+            "counts": {},
+        }
+        self.update()
+
+    def pytest_runtest_logstart(self, nodeid: str) -> None:
+        """Publish the test that is starting.
+
+        Args:
+            nodeid (str): The node ID of the starting test.
+        """
+        self.update(current_test=nodeid)
+
+    def pytest_runtest_logreport(self, report: pytest.TestReport) -> None:
+        """Count the outcome of a finished test phase and publish it as the last result.
+
+        Args:
+            report (pytest.TestReport): The report of the setup, call or teardown phase.
+        """
+        # Passing setup/teardown phases are not results, failures outside the call phase are errors
+        if report.when != "call" and report.passed:
+            return
+
+        outcome = "error" if report.failed and report.when != "call" else report.outcome
+        self.progress["counts"][outcome] = self.progress["counts"].get(outcome, 0) + 1
+        self.update(last_result={"test": report.nodeid, "outcome": outcome})
+
+    def pytest_sessionfinish(self, session: pytest.Session, exitstatus: int) -> None:
+        """Publish that tests are done and session teardown is running.
+
+        This hook may run before conftest's pytest_sessionfinish (resource teardown, must-gather),
+        so the run is reported as finished only in pytest_unconfigure.
+
+        Args:
+            session (pytest.Session): The pytest session.
+            exitstatus (int): The pytest exit status.
+        """
+        self.update(status="tearing_down", current_test="", exit_status=int(exitstatus))
+
+    def pytest_unconfigure(self, config: pytest.Config) -> None:
+        """Publish that the run, including teardown, is finished.
+
+        Args:
+            config (pytest.Config): The pytest config object.
+        """
+        self.update(status="finished")
+
+    def update(self, **updates: Any) -> None:
+        """Apply updates and atomically rewrite the progress file.
+
+        Write failures are logged and never fail the run.
+
+        Args:
+            **updates (Any): Progress fields to update (e.g. current_test, status).
+        """
+        self.progress.update(updates)
+        self.progress["updated_at"] = datetime.now(UTC).isoformat()
+
+        tmp_file = self.progress_file.with_name(f".{self.progress_file.name}.tmp")
+        try:
+            tmp_file.write_text(json.dumps(self.progress))
+            tmp_file.replace(self.progress_file)
+        except OSError as ex:
+            LOGGER.warning(f"Failed to write progress file {self.progress_file}: {ex}")
+
+
+def get_git_run_info() -> dict[str, str]:
+    """Get the branch, commit SHA and dirty state of the mtv-api-tests checkout.
+
+    Values fall back to "unknown" when the suite does not run from a git checkout
+    or git is not available. git_branch is also "unknown" on a detached HEAD (usual in CI),
+    git_commit still identifies the code there. git_dirty ignores untracked files, the suite
+    writes its own reports and logs into the checkout.
+
+    Returns:
+        dict[str, str]: git_branch, git_commit and git_dirty ("true"/"false"/"unknown").
+    """
+    git_base_command = ["git", "-C", str(Path(__file__).resolve().parent.parent)]
+    git_commands = {
+        "git_branch": [*git_base_command, "rev-parse", "--abbrev-ref", "HEAD"],
+        "git_commit": [*git_base_command, "rev-parse", "HEAD"],
+        "git_dirty": [*git_base_command, "status", "--porcelain", "--untracked-files=no"],
+    }
+    git_info: dict[str, str] = {}
+
+    for key, command in git_commands.items():
+        try:
+            # Not being in a git checkout (e.g. the container image) is expected, don't log it as an error
+            success, out, _ = run_command(command=command, check=False, verify_stderr=False, log_errors=False)
+        except OSError as ex:
+            LOGGER.warning(f"Failed to run '{' '.join(command)}': {ex}")
+            success, out = False, ""
+
+        # rev-parse --abbrev-ref prints the literal "HEAD" on a detached checkout
+        if not success or (key == "git_branch" and out.strip() == "HEAD"):
+            git_info[key] = "unknown"
+        elif key == "git_dirty":
+            git_info[key] = str(bool(out.strip())).lower()
+        else:
+            git_info[key] = out.strip()
+
+    return git_info
+
+
+def stamp_junit_git_properties(config: pytest.Config) -> None:
+    """Record the mtv-api-tests git branch, commit and dirty state as JUnit testsuite properties.
+
+    Must run in the controller process, the only one writing the JUnit XML.
+    The JUnit XML plugin is looked up by its public add_global_property method,
+    it is registered without a name.
+
+    Args:
+        config (pytest.Config): The pytest config object.
+    """
+    git_info = get_git_run_info()
+    LOGGER.info(f"mtv-api-tests git info: {git_info}")
+
+    for plugin in config.pluginmanager.get_plugins():
+        if callable(add_global_property := getattr(plugin, "add_global_property", None)):
+            for name, value in git_info.items():
+                add_global_property(name, value)
+
+
 def prepare_base_path(base_path: Path) -> None:
     with contextlib.suppress(FileNotFoundError):
         # When running pytest in parallel (-n) we may get here error even when path exists
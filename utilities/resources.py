@@ -1,3 +1,5 @@
+import getpass
+import re
 from typing import TYPE_CHECKING, Any
 
 import yaml
@@ -6,15 +8,22 @@ from ocp_resources.migration import Migration
 from ocp_resources.namespace import Namespace
 from ocp_resources.plan import Plan
 from ocp_resources.resource import Resource
+from pytest_testconfig import config as py_config
 from simple_logger.logger import get_logger
 
-from utilities.naming import generate_name_with_uuid
+from exceptions.exceptions import InvalidVMNameError
+from utilities.constants import MTV_API_TESTS_LABEL_PREFIX
+from utilities.naming import generate_name_with_uuid, sanitize_kubernetes_name
 
 if TYPE_CHECKING:
     from kubernetes.dynamic import DynamicClient
 
 LOGGER = get_logger(__name__)
 
+RUN_ID_LABEL: str = f"{MTV_API_TESTS_LABEL_PREFIX}/run-id"
+# Kubernetes label value: up to 63 alphanumerics, '-', '_' or '.', starting and ending with an alphanumeric
+_LABEL_VALUE_PATTERN = re.compile(r"[A-Za-z0-9]([-A-Za-z0-9_.]{0,61}[A-Za-z0-9])?")
+
 
 def create_and_store_resource(
     client: "DynamicClient",
@@ -51,6 +60,12 @@ def create_and_store_resource(
 
     kwargs["name"] = _resource_name
 
+    if run_id_labels := get_run_id_labels():
+        if _kind_dict := kwargs.get("kind_dict"):
+            _kind_dict.setdefault("metadata", {}).setdefault("labels", {}).update(run_id_labels)
+        else:
+            kwargs["label"] = {**(kwargs.get("label") or {}), **run_id_labels}
+
     _resource = resource(**kwargs)
 
     try:
@@ -70,6 +85,57 @@ def create_and_store_resource(
     return _resource
 
 
+def get_run_id_labels() -> dict[str, str]:
+    """Build the label identifying the test run, from the optional run_id config.
+
+    The run ID is passed by external runners (e.g. --tc=run_id:<id>) so their cleanup
+    can select only the resources created by one run.
+
+    Returns:
+        dict[str, str]: The run-id label, or an empty dict when run_id is not configured
+
+    Raises:
+        ValueError: If run_id is not a valid Kubernetes label value
+    """
+    run_id = str(py_config.get("run_id") or "")
+    if not run_id:
+        return {}
+
+    if not _LABEL_VALUE_PATTERN.fullmatch(run_id):
+        raise ValueError(
+            f"run_id '{run_id}' is not a valid Kubernetes label value "
+            "(up to 63 alphanumerics, '-', '_' or '.', starting and ending with an alphanumeric)"
+        )
+
+    return {RUN_ID_LABEL: run_id}
+
+
+def get_namespace_ownership_labels(fixture_store: dict[str, Any]) -> dict[str, str]:
+    """Build labels identifying who created a test namespace and for which run.
+
+    The labels let cleanup tooling select namespaces created by the suite
+    without relying on name-prefix matching.
+
+    Args:
+        fixture_store (dict[str, Any]): Fixture store containing session_uuid
+
+    Returns:
+        dict[str, str]: Namespace labels with owner, session UUID and source provider
+    """
+    try:
+        owner = sanitize_kubernetes_name(name=getpass.getuser())
+    except (KeyError, OSError, InvalidVMNameError):
+        # Containers running with an arbitrary UID have no passwd entry and no USER/LOGNAME env,
+        # a user name without alphanumerics cannot be used as a label value
+        owner = "unknown"
+
+    return {
+        f"{MTV_API_TESTS_LABEL_PREFIX}/owner": owner,
+        f"{MTV_API_TESTS_LABEL_PREFIX}/session-uuid": fixture_store["session_uuid"],
+        f"{MTV_API_TESTS_LABEL_PREFIX}/source-provider": sanitize_kubernetes_name(name=py_config["source_provider"]),
+    }
+
+
 def get_or_create_namespace(
     fixture_store: dict[str, Any],
     ocp_admin_client: "DynamicClient",
@@ -77,7 +143,7 @@ def get_or_create_namespace(
 ) -> str:
     """Get or create a namespace, ensuring it exists and is active.
 
-    Checks if namespace exists. If not, creates it with standard labels.
+    Checks if namespace exists. If not, creates it with standard and ownership labels.
     Only adds to fixture_store teardown if creating new namespace (not if reusing existing).
 
     Args:
@@ -106,6 +172,7 @@ def get_or_create_namespace(
                 "pod-security.kubernetes.io/enforce": "restricted",
                 "pod-security.kubernetes.io/enforce-version": "latest",
                 "mutatevirtualmachines.kubemacpool.io": "ignore",
+                **get_namespace_ownership_labels(fixture_store=fixture_store),
             },
         )
     ns.wait_for_status(status=ns.Status.ACTIVE)